@@ -0,0 +1,209 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/promiseofcake/dependawhat/internal/policy"
+	"github.com/promiseofcake/dependawhat/internal/scm"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// rebaseCommentBody is the comment convention most dependency bots
+// recognize as a request to rebase/recreate a PR.
+const rebaseCommentBody = "@dependabot rebase"
+
+var (
+	actMessage string
+
+	actCmd = &cobra.Command{
+		Use:   "act",
+		Short: "Act on open Dependabot PRs (approve, comment, rebase, close)",
+		Long: `Act on the Dependabot/Renovate PRs a "check" run would surface.
+
+Unlike "check", this mutates PRs/MRs: approving, commenting, rebasing, or
+closing them. Every subcommand only acts on PRs matching the target
+repository's "actions:" policy in the config file, e.g.:
+
+  repositories:
+    owner/repo:
+      actions:
+        auto_approve_when: "status == success && !skipped && package matches ^github.com/aws/"
+
+A repository with no matching "auto_<verb>_when" policy is left untouched.
+Mutations require --yes; pass --dry-run to see what would happen without
+calling the API. Every mutation (or would-be mutation) is logged as a
+JSON line on stderr for auditing.`,
+	}
+
+	actApproveCmd = &cobra.Command{
+		Use:   "approve [owner/repo...]",
+		Short: "Approve PRs matching the auto_approve_when policy",
+		RunE:  runAct("approve", ""),
+	}
+
+	actCommentCmd = &cobra.Command{
+		Use:   "comment [owner/repo...]",
+		Short: "Comment on PRs matching the auto_comment_when policy",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if actMessage == "" {
+				return fmt.Errorf("comment requires --message")
+			}
+			return runAct("comment", actMessage)(cmd, args)
+		},
+	}
+
+	actRebaseCmd = &cobra.Command{
+		Use:   "rebase [owner/repo...]",
+		Short: `Post "@dependabot rebase" on PRs matching the auto_rebase_when policy`,
+		RunE:  runAct("rebase", rebaseCommentBody),
+	}
+
+	actCloseCmd = &cobra.Command{
+		Use:   "close [owner/repo...]",
+		Short: "Close PRs matching the auto_close_when policy",
+		RunE:  runAct("close", ""),
+	}
+)
+
+func init() {
+	actCmd.PersistentFlags().Bool("yes", false, "actually perform the action (required unless --dry-run)")
+	actCmd.PersistentFlags().Bool("dry-run", false, "print the intended action for each matching PR without calling the API")
+
+	actCommentCmd.Flags().StringVar(&actMessage, "message", "", "comment body to post")
+
+	actCmd.AddCommand(actApproveCmd, actCommentCmd, actRebaseCmd, actCloseCmd)
+	rootCmd.AddCommand(actCmd)
+}
+
+// runAct returns a RunE that acts on every repo's PRs matching its
+// "auto_<verb>_when" policy. body is the comment text for "comment" and
+// "rebase"; it's ignored by "approve" and "close".
+func runAct(verb, body string) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		yes, _ := cmd.Flags().GetBool("yes")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		if !yes && !dryRun {
+			return fmt.Errorf("refusing to %s without --yes (pass --dry-run to preview instead)", verb)
+		}
+
+		repos, err := reposToCheck(args)
+		if err != nil {
+			return err
+		}
+
+		for _, repoPath := range repos {
+			provider, owner, repo, err := resolveRepo(repoPath)
+			if err != nil {
+				fmt.Printf("  %v\n", err)
+				continue
+			}
+			repoKey := fmt.Sprintf("%s/%s", owner, repo)
+			apiURL := viper.GetString("repositories." + repoKey + ".api_url")
+
+			policyKey := "repositories." + repoKey + ".actions.auto_" + verb + "_when"
+			expr := viper.GetString(policyKey)
+			if expr == "" {
+				fmt.Printf("%s: no %s policy configured, skipping\n", repoKey, policyKey)
+				continue
+			}
+			match, err := policy.Parse(expr)
+			if err != nil {
+				fmt.Printf("%s: %v\n", repoKey, err)
+				continue
+			}
+
+			c, err := newProviderClient(provider, apiURL)
+			if err != nil {
+				fmt.Printf("%s: %v\n", repoKey, err)
+				continue
+			}
+
+			q := scm.DependencyUpdateQuery{
+				Owner:          owner,
+				Repo:           repo,
+				BaseURL:        apiURL,
+				BotUsername:    botUsername(repoKey),
+				DeniedPackages: deniedPackageMatchers(repoKey),
+				DeniedOrgs:     removeDuplicates(append(getStringSlice("global.denied_orgs"), getStringSlice("repositories."+repoKey+".denied_orgs")...)),
+			}
+
+			prs, err := c.ListDependencyPRs(ctx, q)
+			if err != nil {
+				fmt.Printf("%s: %v\n", repoKey, err)
+				continue
+			}
+
+			for _, pr := range prs {
+				if !match(policy.Fields{Status: pr.Status, Skipped: pr.Skipped, Package: pr.PackageName}) {
+					continue
+				}
+				actOnPR(ctx, c, q, repoKey, verb, body, pr, dryRun)
+			}
+		}
+
+		return nil
+	}
+}
+
+// actOnPR performs (or, if dryRun, simulates) verb against pr, printing and
+// auditing the result.
+func actOnPR(ctx context.Context, c scm.SCMProvider, q scm.DependencyUpdateQuery, repoKey, verb, body string, pr scm.PRInfo, dryRun bool) {
+	if dryRun {
+		fmt.Printf("%s #%d: [dry-run] would %s\n", repoKey, pr.Number, verb)
+		auditAction(repoKey, verb, pr.Number, true, nil)
+		return
+	}
+
+	var err error
+	switch verb {
+	case "approve":
+		err = c.Approve(ctx, q, pr.Number)
+	case "comment", "rebase":
+		err = c.Comment(ctx, q, pr.Number, body)
+	case "close":
+		err = c.Close(ctx, q, pr.Number)
+	default:
+		err = fmt.Errorf("unknown action: %s", verb)
+	}
+
+	if err != nil {
+		fmt.Printf("%s #%d: %s failed: %v\n", repoKey, pr.Number, verb, err)
+	} else {
+		fmt.Printf("%s #%d: %s applied\n", repoKey, pr.Number, verb)
+	}
+	auditAction(repoKey, verb, pr.Number, false, err)
+}
+
+// auditEntry is a single structured log line recording a mutation (or a
+// dry-run preview of one) for auditing.
+type auditEntry struct {
+	Repository string `json:"repository"`
+	Action     string `json:"action"`
+	PRNumber   int    `json:"pr_number"`
+	DryRun     bool   `json:"dry_run"`
+	Error      string `json:"error,omitempty"`
+}
+
+func auditAction(repoKey, verb string, number int, dryRun bool, err error) {
+	entry := auditEntry{
+		Repository: repoKey,
+		Action:     verb,
+		PRNumber:   number,
+		DryRun:     dryRun,
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	fmt.Fprintln(os.Stderr, string(b))
+}
@@ -4,13 +4,22 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/promiseofcake/dependawhat/internal/scm"
+	"github.com/promiseofcake/dependawhat/internal/scm/registry"
+	"github.com/promiseofcake/dependawhat/internal/scm/scorecard"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
+// defaultScorecardCacheTTL is how long a fetched Scorecard result is
+// trusted before require_scorecard re-fetches it.
+const defaultScorecardCacheTTL = 24 * time.Hour
+
 var (
 	checkCmd = &cobra.Command{
 		Use:   "check [owner/repo...]",
@@ -22,6 +31,45 @@ configured in the 'repositories' section of your config file.
 
 You can specify multiple repositories: check owner1/repo1 owner2/repo2
 
+Repositories are checked against GitHub by default. Prefix a repository
+with its host (gitlab.com/group/project) or set a 'provider:' field on
+its config entry to check it against GitLab or a self-hosted Gitea/Forgejo
+instance instead, so a single config can monitor repos across all three
+in one pass. Self-hosted providers also need an 'api_url:' field on the
+repository's config entry.
+
+GitLab and Gitea/Forgejo PRs are matched by the author's username, since
+neither has a universal bot App ID like GitHub's Dependabot. This
+defaults to "renovate-bot", which most self-hosted installs override -
+set 'bot_username:' under 'global:' or on a repository's config entry to
+match the account your installation actually uses.
+
+'denied_packages' entries support plain package names, globs (e.g.
+"github.com/aws/**"), "re:"-prefixed regular expressions, and
+"<package>@<semver constraint>" entries scoped to one package (e.g.
+"github.com/gin-gonic/gin@<1.9.0").
+
+Each PR's package is looked up against its upstream registry (Go module
+proxy, npm, or PyPI) to show the latest published version, its license,
+and any known vulnerabilities affecting the target version (from OSV,
+https://osv.dev). The registry is guessed from the package name, which
+can't always tell npm and PyPI apart (e.g. "requests" is a valid name in
+both) - set 'ecosystem: pypi' on a repository's config entry to override
+the guess. Set 'min_age: 3d'
+to skip PRs bumping to versions published more recently than that, or
+'deny_deprecated: true' to skip PRs bumping to a version the registry
+marks as deprecated. Both can be set under 'global:' or on
+a specific repository's config entry.
+
+Set 'require_scorecard: {min: 6.0, checks: {Maintained: 5}}' on a
+repository's config entry to also gate on the package's OSSF Scorecard
+(https://securityscorecards.dev) score: PRs whose source repository
+scores below 'min' overall, or below a listed check's minimum, are
+skipped with a "scorecard ..." reason. Scorecard results are cached on
+disk (see 'global.scorecard_cache_ttl', default 24h) and the gate is
+skipped - not failed - when the source repository can't be resolved,
+isn't scored, or the Scorecard API is unreachable.
+
 This is a read-only operation - it only displays PR information and does
 not perform any actions on the PRs.`,
 		RunE: runCheck,
@@ -31,100 +79,101 @@ not perform any actions on the PRs.`,
 func runCheck(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 
-	// Get GitHub token
-	token := viper.GetString("github-token")
-	if token == "" {
-		return fmt.Errorf("GitHub token not provided. Use --github-token flag or set USER_GITHUB_TOKEN environment variable")
-	}
-
-	// Get list of repositories to check
-	var repos []string
-
-	if len(args) > 0 {
-		// Use command-line arguments
-		repos = args
-	} else {
-		// Get all configured repositories from the main config
-		repoMap := viper.GetStringMap("repositories")
-		for repo := range repoMap {
-			repos = append(repos, repo)
-		}
-
-		// If no repositories in main config, check for legacy check.repositories
-		if len(repos) == 0 {
-			repos = viper.GetStringSlice("check.repositories")
-		}
-	}
-
-	if len(repos) == 0 {
-		return fmt.Errorf("no repositories specified. Use command-line arguments or configure repositories in config file")
+	repos, err := reposToCheck(args)
+	if err != nil {
+		return err
 	}
 
-	// Create GitHub client
-	c := scm.NewGithubClient(http.DefaultClient, token)
+	// Shared across repos so PRs bumping the same dependency only hit the
+	// registry once per run.
+	reg := registry.NewClient(http.DefaultClient)
+	sc := scorecard.NewClient(http.DefaultClient, scorecardCacheDir(), scorecardCacheTTL())
 
 	fmt.Println("Open Dependabot PRs:")
 	fmt.Println("-------------------------")
 
 	for _, repoPath := range repos {
-		parts := strings.Split(repoPath, "/")
-		if len(parts) != 2 {
-			fmt.Printf("  Invalid repository format: %s (expected owner/repo)\n\n", repoPath)
+		provider, owner, repo, err := resolveRepo(repoPath)
+		if err != nil {
+			fmt.Printf("  %v\n\n", err)
 			continue
 		}
 
-		owner, repo := parts[0], parts[1]
 		fmt.Printf("%s/%s\n", owner, repo)
 
 		// Build query with deny lists
 		repoKey := fmt.Sprintf("%s/%s", owner, repo)
+		apiURL := viper.GetString("repositories." + repoKey + ".api_url")
 
-		// Get deny lists - merge global and repo-specific
-		deniedPackages := getStringSlice("global.denied_packages")
-		deniedOrgs := getStringSlice("global.denied_orgs")
-
-		// Add repo-specific denies
-		deniedPackages = append(deniedPackages, getStringSlice("repositories."+repoKey+".denied_packages")...)
-		deniedOrgs = append(deniedOrgs, getStringSlice("repositories."+repoKey+".denied_orgs")...)
+		c, err := newProviderClient(provider, apiURL)
+		if err != nil {
+			fmt.Printf("   Error: %v\n\n", err)
+			continue
+		}
 
-		// Remove duplicates
-		deniedPackages = removeDuplicates(deniedPackages)
-		deniedOrgs = removeDuplicates(deniedOrgs)
+		// Get deny lists - merge global and repo-specific
+		deniedPackages := deniedPackageMatchers(repoKey)
+		deniedOrgs := removeDuplicates(append(getStringSlice("global.denied_orgs"), getStringSlice("repositories."+repoKey+".denied_orgs")...))
 
 		q := scm.DependencyUpdateQuery{
 			Owner:          owner,
 			Repo:           repo,
+			BaseURL:        apiURL,
+			BotUsername:    botUsername(repoKey),
 			DeniedPackages: deniedPackages,
 			DeniedOrgs:     deniedOrgs,
 		}
 
 		// Get open Dependabot PRs with deny list info
-		prs, err := c.GetDependabotPRsWithDenyList(ctx, q)
+		prs, err := c.ListDependencyPRs(ctx, q)
 		if err != nil {
 			fmt.Printf("   Error: %v\n\n", err)
 			continue
 		}
 
+		// Enrich with upstream registry metadata and re-evaluate the deny
+		// decision against it (min_age / deny_deprecated), then the same for
+		// require_scorecard.
+		for i := range prs {
+			enrichWithRegistry(ctx, reg, &prs[i], repoKey)
+			enrichWithScorecard(ctx, sc, &prs[i], repoKey)
+		}
+
 		if len(prs) == 0 {
 			fmt.Println("   (no open Dependabot PRs)")
 		} else {
 			for _, pr := range prs {
+				fmt.Printf("   #%d: %s\n", pr.Number, pr.Title)
+				fmt.Printf("   %s\n", pr.URL)
+				if pr.LatestVersion != "" {
+					fmt.Printf("   Latest: %s", pr.LatestVersion)
+					if !pr.PublishedAt.IsZero() {
+						fmt.Printf(" (published %s)", pr.PublishedAt.Format("2006-01-02"))
+					}
+					if pr.Deprecated {
+						fmt.Printf(" [DEPRECATED]")
+					}
+					fmt.Println()
+				}
+				if pr.License != "" {
+					fmt.Printf("   License: %s\n", pr.License)
+				}
+				if len(pr.KnownVulnerabilities) > 0 {
+					fmt.Printf("   Known vulnerabilities: %s\n", strings.Join(pr.KnownVulnerabilities, ", "))
+				}
+				if pr.ScorecardRepo != "" {
+					fmt.Printf("   Scorecard: %.1f (%s)\n", pr.ScorecardScore, pr.ScorecardRepo)
+				}
 				if pr.Skipped {
-					fmt.Printf("   #%d: %s\n", pr.Number, pr.Title)
-					fmt.Printf("   %s\n", pr.URL)
 					fmt.Printf("   Status: SKIPPED (%s)\n", pr.SkipReason)
-				} else {
-					fmt.Printf("   #%d: %s\n", pr.Number, pr.Title)
-					fmt.Printf("   %s\n", pr.URL)
-					if pr.Status != "" {
-						statusIcon := "[pending]"
-						if pr.Status == "success" {
-							statusIcon = "[success]"
-						} else if pr.Status == "failure" {
-							statusIcon = "[failure]"
-						}
-						fmt.Printf("   Status: %s %s\n", statusIcon, pr.Status)
+				} else if pr.Status != "" {
+					statusIcon := "[pending]"
+					if pr.Status == "success" {
+						statusIcon = "[success]"
+					} else if pr.Status == "failure" {
+						statusIcon = "[failure]"
 					}
+					fmt.Printf("   Status: %s %s\n", statusIcon, pr.Status)
 				}
 				fmt.Println()
 			}
@@ -137,6 +186,304 @@ func runCheck(cmd *cobra.Command, args []string) error {
 
 // Helper functions
 
+// reposToCheck returns the repository arguments to operate on: the
+// command-line args if any were given, otherwise every repository
+// configured under 'repositories', falling back to the legacy
+// 'check.repositories' key.
+func reposToCheck(args []string) ([]string, error) {
+	if len(args) > 0 {
+		return args, nil
+	}
+
+	var repos []string
+
+	repoMap := viper.GetStringMap("repositories")
+	for repo := range repoMap {
+		repos = append(repos, repo)
+	}
+
+	if len(repos) == 0 {
+		repos = viper.GetStringSlice("check.repositories")
+	}
+
+	if len(repos) == 0 {
+		return nil, fmt.Errorf("no repositories specified. Use command-line arguments or configure repositories in config file")
+	}
+
+	return repos, nil
+}
+
+// enrichWithRegistry fills in pr's upstream registry metadata and, once
+// known, re-evaluates whether the PR should be skipped against the
+// repository's "min_age" and "deny_deprecated" settings. It's a no-op for
+// PRs already skipped by the deny lists or whose package/ecosystem
+// couldn't be determined.
+func enrichWithRegistry(ctx context.Context, reg *registry.Client, pr *scm.PRInfo, repoKey string) {
+	if pr.Skipped || pr.PackageName == "" {
+		return
+	}
+
+	ecosystem := ecosystemOverride(repoKey)
+	if ecosystem == registry.EcosystemUnknown {
+		ecosystem = registry.DetectEcosystem(pr.PackageName)
+	}
+	if ecosystem == registry.EcosystemUnknown {
+		return
+	}
+
+	meta, err := reg.Lookup(ctx, ecosystem, pr.PackageName, pr.ToVersion)
+	if err != nil {
+		return
+	}
+
+	pr.LatestVersion = meta.LatestVersion
+	pr.PublishedAt = meta.PublishedAt
+	pr.Deprecated = meta.Deprecated
+	pr.License = meta.License
+	pr.KnownVulnerabilities = meta.KnownVulnerabilities
+	pr.RepositoryURL = meta.RepositoryURL
+
+	denyDeprecated := viper.GetBool("global.deny_deprecated") || viper.GetBool("repositories."+repoKey+".deny_deprecated")
+	if denyDeprecated && pr.Deprecated {
+		pr.Skipped = true
+		pr.SkipReason = fmt.Sprintf("package '%s' is deprecated", pr.PackageName)
+		return
+	}
+
+	minAge := viper.GetString("repositories." + repoKey + ".min_age")
+	if minAge == "" {
+		minAge = viper.GetString("global.min_age")
+	}
+	if minAge == "" || pr.PublishedAt.IsZero() {
+		return
+	}
+
+	threshold, err := registry.ParseAge(minAge)
+	if err != nil {
+		return
+	}
+
+	if age := time.Since(pr.PublishedAt); age < threshold {
+		pr.Skipped = true
+		pr.SkipReason = fmt.Sprintf("version %s published %s ago, younger than min_age %s", pr.ToVersion, age.Round(time.Hour), minAge)
+	}
+}
+
+// enrichWithScorecard resolves pr's package to its GitHub source repository
+// and, if the repository's config entry sets "require_scorecard", fetches
+// its OSSF Scorecard and skips the PR when it falls short. It's a no-op for
+// PRs already skipped, repositories with no "require_scorecard" configured,
+// and packages whose source repository can't be resolved to github.com -
+// the gate only ever adds skips, it never fails the run.
+func enrichWithScorecard(ctx context.Context, sc *scorecard.Client, pr *scm.PRInfo, repoKey string) {
+	if pr.Skipped {
+		return
+	}
+
+	req, ok := scorecardRequirement(repoKey)
+	if !ok {
+		return
+	}
+
+	repo, ok := scorecard.ParseGitHubRepo(pr.RepositoryURL)
+	if !ok {
+		repo, ok = scorecard.ParseGitHubRepo(pr.PackageName)
+	}
+	if !ok {
+		return
+	}
+
+	result, err := sc.Lookup(ctx, repo)
+	if err != nil || result.Repo == "" {
+		return
+	}
+
+	pr.ScorecardRepo = result.Repo
+	pr.ScorecardScore = result.Score
+	pr.ScorecardChecks = result.CheckScores
+
+	if passed, reason := req.Evaluate(result); !passed {
+		pr.Skipped = true
+		pr.SkipReason = reason
+	}
+}
+
+// scorecardRequirement reads a repository's "require_scorecard" config
+// entry, if any.
+func scorecardRequirement(repoKey string) (scorecard.Requirement, bool) {
+	key := "repositories." + repoKey + ".require_scorecard"
+	if !viper.IsSet(key) {
+		return scorecard.Requirement{}, false
+	}
+
+	req := scorecard.Requirement{
+		Min:    viper.GetFloat64(key + ".min"),
+		Checks: make(map[string]float64),
+	}
+	for name := range viper.GetStringMap(key + ".checks") {
+		// GetFloat64 handles YAML's bare integers (e.g. "Maintained: 5"),
+		// which decode as int rather than float64.
+		req.Checks[name] = viper.GetFloat64(key + ".checks." + name)
+	}
+
+	return req, true
+}
+
+// scorecardCacheDir returns the directory Scorecard results are cached
+// under, honoring "global.scorecard_cache_dir" and otherwise defaulting to
+// a "scorecard" subdirectory of the user's cache directory.
+func scorecardCacheDir() string {
+	if dir := viper.GetString("global.scorecard_cache_dir"); dir != "" {
+		return dir
+	}
+	base, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(base, "dependawhat", "scorecard")
+}
+
+// scorecardCacheTTL returns how long a cached Scorecard result is trusted,
+// honoring "global.scorecard_cache_ttl" (e.g. "24h" or "7d").
+func scorecardCacheTTL() time.Duration {
+	ttl := viper.GetString("global.scorecard_cache_ttl")
+	if ttl == "" {
+		return defaultScorecardCacheTTL
+	}
+	d, err := registry.ParseAge(ttl)
+	if err != nil {
+		return defaultScorecardCacheTTL
+	}
+	return d
+}
+
+// resolveRepo determines which SCM provider a repository argument belongs
+// to and splits it into an owner/namespace and repo name. A leading
+// "github.com/" or "gitlab.com/" segment picks the provider explicitly;
+// otherwise the repository's config entry is consulted for a "provider:"
+// field, defaulting to GitHub. Self-hosted Gitea/Forgejo instances have no
+// fixed host to match on, so they must always be configured with an
+// explicit "provider: gitea" entry. The final path segment is treated as
+// the repo name and everything before it as the owner, so GitLab's
+// group/subgroup/project namespaces are preserved.
+func resolveRepo(repoPath string) (provider, owner, repo string, err error) {
+	trimmed := repoPath
+	switch {
+	case strings.HasPrefix(trimmed, "github.com/"):
+		provider = "github"
+		trimmed = strings.TrimPrefix(trimmed, "github.com/")
+	case strings.HasPrefix(trimmed, "gitlab.com/"):
+		provider = "gitlab"
+		trimmed = strings.TrimPrefix(trimmed, "gitlab.com/")
+	}
+
+	parts := strings.Split(trimmed, "/")
+	if len(parts) < 2 {
+		return "", "", "", fmt.Errorf("invalid repository format: %s (expected owner/repo)", repoPath)
+	}
+	owner = strings.Join(parts[:len(parts)-1], "/")
+	repo = parts[len(parts)-1]
+
+	if provider == "" {
+		repoKey := fmt.Sprintf("%s/%s", owner, repo)
+		provider = viper.GetString("repositories." + repoKey + ".provider")
+		if provider == "" {
+			provider = "github"
+		}
+	}
+
+	return provider, owner, repo, nil
+}
+
+// newProviderClient builds the SCMProvider for the named provider, reading
+// its token from the matching viper key (e.g. "github-token", "gitlab-token")
+// and, for self-hosted providers, the repository's "api_url" override.
+func newProviderClient(provider, apiURL string) (scm.SCMProvider, error) {
+	switch provider {
+	case "github":
+		token := viper.GetString("github-token")
+		if token == "" {
+			return nil, fmt.Errorf("GitHub token not provided. Use --github-token flag or set USER_GITHUB_TOKEN environment variable")
+		}
+		return scm.NewGithubClient(http.DefaultClient, token), nil
+	case "gitlab":
+		token := viper.GetString("gitlab-token")
+		if token == "" {
+			return nil, fmt.Errorf("GitLab token not provided. Use --gitlab-token flag or set USER_GITLAB_TOKEN environment variable")
+		}
+		baseURL := apiURL
+		if baseURL == "" {
+			baseURL = viper.GetString("gitlab-base-url")
+		}
+		return scm.NewGitlabClient(token, baseURL)
+	case "gitea":
+		token := viper.GetString("gitea-token")
+		if token == "" {
+			return nil, fmt.Errorf("Gitea token not provided. Use --gitea-token flag or set USER_GITEA_TOKEN environment variable")
+		}
+		if apiURL == "" {
+			return nil, fmt.Errorf("Gitea requires an 'api_url' field on the repository's config entry")
+		}
+		return scm.NewGiteaClient(apiURL, token)
+	default:
+		return nil, fmt.Errorf("unknown provider: %s", provider)
+	}
+}
+
+// deniedPackageMatchers compiles the merged global and repo-specific
+// "denied_packages" entries into Matchers. An entry that fails to compile
+// (e.g. a malformed regex or semver constraint) is reported and skipped
+// rather than aborting the whole run.
+func deniedPackageMatchers(repoKey string) []scm.Matcher {
+	rules := removeDuplicates(append(getStringSlice("global.denied_packages"), getStringSlice("repositories."+repoKey+".denied_packages")...))
+
+	matchers := make([]scm.Matcher, 0, len(rules))
+	for _, rule := range rules {
+		m, err := scm.ParseDenyRule(rule)
+		if err != nil {
+			fmt.Printf("   %v\n", err)
+			continue
+		}
+		matchers = append(matchers, m)
+	}
+
+	return matchers
+}
+
+// ecosystemOverride returns the repository's configured "ecosystem"
+// (falling back to "global.ecosystem"), or EcosystemUnknown if neither is
+// set or the value isn't recognized. It takes precedence over
+// registry.DetectEcosystem's name-based guess, since names alone can't
+// always tell npm and PyPI packages apart.
+func ecosystemOverride(repoKey string) registry.Ecosystem {
+	value := viper.GetString("repositories." + repoKey + ".ecosystem")
+	if value == "" {
+		value = viper.GetString("global.ecosystem")
+	}
+
+	switch strings.ToLower(value) {
+	case "go":
+		return registry.EcosystemGo
+	case "npm":
+		return registry.EcosystemNPM
+	case "pypi":
+		return registry.EcosystemPyPI
+	default:
+		return registry.EcosystemUnknown
+	}
+}
+
+// botUsername returns the username the dependency bot runs under for
+// repoKey's GitLab/Gitea repository, preferring a repo-specific
+// "bot_username" config entry over "global.bot_username". Empty means the
+// provider should fall back to DefaultRenovateBotUsername.
+func botUsername(repoKey string) string {
+	if v := viper.GetString("repositories." + repoKey + ".bot_username"); v != "" {
+		return v
+	}
+	return viper.GetString("global.bot_username")
+}
+
 func getStringSlice(key string) []string {
 	if viper.IsSet(key) {
 		return viper.GetStringSlice(key)
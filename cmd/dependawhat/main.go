@@ -14,11 +14,11 @@ var (
 	rootCmd = &cobra.Command{
 		Use:   "dependawhat",
 		Short: "Check for open Dependabot PRs",
-		Long: `A read-only tool to check for open Dependabot pull requests.
+		Long: `A tool to check and act on open Dependabot pull requests.
 
-Lists all open Dependabot PRs across configured repositories with their
-CI status and deny list information. Perfect for monitoring dependency
-updates without the ability to approve, recreate, or close PRs.
+'check' lists all open Dependabot PRs across configured repositories
+with their CI status and deny list information. 'act' lets you approve,
+comment on, rebase, or close matching PRs in bulk.
 
 Configuration can be provided via YAML file or command-line flags.`,
 	}
@@ -30,11 +30,17 @@ func init() {
 	// Global flags
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.dependawhat/config.yaml)")
 	rootCmd.PersistentFlags().String("github-token", "", "GitHub token (defaults to USER_GITHUB_TOKEN env var)")
+	rootCmd.PersistentFlags().String("gitlab-token", "", "GitLab token (defaults to USER_GITLAB_TOKEN env var)")
+	rootCmd.PersistentFlags().String("gitlab-base-url", "", "GitLab API base URL, for self-hosted instances (defaults to gitlab.com)")
+	rootCmd.PersistentFlags().String("gitea-token", "", "Gitea/Forgejo token (defaults to USER_GITEA_TOKEN env var)")
 	rootCmd.PersistentFlags().StringSlice("deny-packages", []string{}, "Packages to deny")
 	rootCmd.PersistentFlags().StringSlice("deny-orgs", []string{}, "Organizations to deny")
 
 	// Bind flags to viper
 	viper.BindPFlag("github-token", rootCmd.PersistentFlags().Lookup("github-token"))
+	viper.BindPFlag("gitlab-token", rootCmd.PersistentFlags().Lookup("gitlab-token"))
+	viper.BindPFlag("gitlab-base-url", rootCmd.PersistentFlags().Lookup("gitlab-base-url"))
+	viper.BindPFlag("gitea-token", rootCmd.PersistentFlags().Lookup("gitea-token"))
 	viper.BindPFlag("deny-packages", rootCmd.PersistentFlags().Lookup("deny-packages"))
 	viper.BindPFlag("deny-orgs", rootCmd.PersistentFlags().Lookup("deny-orgs"))
 
@@ -64,8 +70,10 @@ func initConfig() {
 	viper.SetEnvPrefix("DEPENDAWHAT")
 	viper.AutomaticEnv()
 
-	// Also check for USER_GITHUB_TOKEN specifically
+	// Also check for USER_GITHUB_TOKEN, USER_GITLAB_TOKEN and USER_GITEA_TOKEN specifically
 	viper.BindEnv("github-token", "USER_GITHUB_TOKEN")
+	viper.BindEnv("gitlab-token", "USER_GITLAB_TOKEN")
+	viper.BindEnv("gitea-token", "USER_GITEA_TOKEN")
 
 	// Read config file if it exists
 	if err := viper.ReadInConfig(); err == nil {
@@ -0,0 +1,81 @@
+// Package policy evaluates the small boolean expression language used by
+// the "actions:" section of a repository's config, e.g.
+//
+//	auto_approve_when: status == success && !skipped && package matches ^github.com/aws/
+package policy
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Fields holds the PR attributes a policy expression can test against.
+type Fields struct {
+	Status  string
+	Skipped bool
+	Package string
+}
+
+// Predicate reports whether fields satisfies a compiled policy expression.
+type Predicate func(fields Fields) bool
+
+// Parse compiles expr into a Predicate. Clauses are joined with "&&"; every
+// clause must hold for the predicate to be true. Supported clauses are:
+//
+//	status == <value>     status != <value>
+//	skipped                !skipped
+//	package matches <regex>
+func Parse(expr string) (Predicate, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return nil, fmt.Errorf("empty policy expression")
+	}
+
+	var clauses []func(Fields) bool
+	for _, raw := range strings.Split(expr, "&&") {
+		clause := strings.TrimSpace(raw)
+		if clause == "" {
+			return nil, fmt.Errorf("empty clause in policy expression %q", expr)
+		}
+
+		fn, err := parseClause(clause)
+		if err != nil {
+			return nil, fmt.Errorf("policy expression %q: %w", expr, err)
+		}
+		clauses = append(clauses, fn)
+	}
+
+	return func(fields Fields) bool {
+		for _, fn := range clauses {
+			if !fn(fields) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+func parseClause(clause string) (func(Fields) bool, error) {
+	switch {
+	case clause == "skipped":
+		return func(f Fields) bool { return f.Skipped }, nil
+	case clause == "!skipped":
+		return func(f Fields) bool { return !f.Skipped }, nil
+	case strings.HasPrefix(clause, "status =="):
+		want := strings.TrimSpace(strings.TrimPrefix(clause, "status =="))
+		return func(f Fields) bool { return f.Status == want }, nil
+	case strings.HasPrefix(clause, "status !="):
+		want := strings.TrimSpace(strings.TrimPrefix(clause, "status !="))
+		return func(f Fields) bool { return f.Status != want }, nil
+	case strings.HasPrefix(clause, "package matches"):
+		pattern := strings.TrimSpace(strings.TrimPrefix(clause, "package matches"))
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid package regex %q: %w", pattern, err)
+		}
+		return func(f Fields) bool { return re.MatchString(f.Package) }, nil
+	default:
+		return nil, fmt.Errorf("unrecognized clause %q", clause)
+	}
+}
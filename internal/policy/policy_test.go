@@ -0,0 +1,113 @@
+package policy
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		fields  Fields
+		wantErr bool
+		want    bool
+	}{
+		{
+			name:   "skipped matches",
+			expr:   "skipped",
+			fields: Fields{Skipped: true},
+			want:   true,
+		},
+		{
+			name:   "skipped no match",
+			expr:   "skipped",
+			fields: Fields{Skipped: false},
+			want:   false,
+		},
+		{
+			name:   "negated skipped",
+			expr:   "!skipped",
+			fields: Fields{Skipped: false},
+			want:   true,
+		},
+		{
+			name:   "status equals",
+			expr:   "status == success",
+			fields: Fields{Status: "success"},
+			want:   true,
+		},
+		{
+			name:   "status equals no match",
+			expr:   "status == success",
+			fields: Fields{Status: "failure"},
+			want:   false,
+		},
+		{
+			name:   "status not equals",
+			expr:   "status != failure",
+			fields: Fields{Status: "success"},
+			want:   true,
+		},
+		{
+			name:   "package matches regex",
+			expr:   "package matches ^github.com/aws/",
+			fields: Fields{Package: "github.com/aws/aws-sdk-go"},
+			want:   true,
+		},
+		{
+			name:   "package matches regex no match",
+			expr:   "package matches ^github.com/aws/",
+			fields: Fields{Package: "github.com/gin-gonic/gin"},
+			want:   false,
+		},
+		{
+			name:   "combined clauses all true",
+			expr:   "status == success && !skipped && package matches ^github.com/aws/",
+			fields: Fields{Status: "success", Skipped: false, Package: "github.com/aws/aws-sdk-go"},
+			want:   true,
+		},
+		{
+			name:   "combined clauses one false",
+			expr:   "status == success && !skipped && package matches ^github.com/aws/",
+			fields: Fields{Status: "success", Skipped: true, Package: "github.com/aws/aws-sdk-go"},
+			want:   false,
+		},
+		{
+			name:    "empty expression",
+			expr:    "",
+			wantErr: true,
+		},
+		{
+			name:    "empty clause",
+			expr:    "skipped && ",
+			wantErr: true,
+		},
+		{
+			name:    "unrecognized clause",
+			expr:    "bogus",
+			wantErr: true,
+		},
+		{
+			name:    "invalid package regex",
+			expr:    "package matches (",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			predicate, err := Parse(tt.expr)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Parse(%q) = nil error, want error", tt.expr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %v", tt.expr, err)
+			}
+
+			if got := predicate(tt.fields); got != tt.want {
+				t.Errorf("Parse(%q)(%+v) = %v, want %v", tt.expr, tt.fields, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package scm
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// toVersionRegex matches the version a dependency bot PR/MR title bumps to.
+// It isn't anchored to the end of the title, since monorepo titles append a
+// directory after the version, e.g. "Bump lodash from 1 to 2 in /frontend".
+var toVersionRegex = regexp.MustCompile(`(?i)\bto\s+(\S+)`)
+
+// extractPackageInfo extracts the package name, organization, and target
+// version from a dependency bot PR/MR title.
+// Examples:
+// "Bump github.com/datadog/datadog-go from 1.0.0 to 2.0.0" -> "github.com/datadog/datadog-go", "datadog", "2.0.0"
+// "Bump @datadog/browser-rum from 4.0.0 to 5.0.0" -> "@datadog/browser-rum", "datadog", "5.0.0"
+// "Update rails to 7.0.0" -> "rails", "", "7.0.0"
+func extractPackageInfo(title string) (packageName string, orgName string, toVersion string) {
+	// Common patterns for dependency bot PR/MR titles
+	patterns := []struct {
+		regex    *regexp.Regexp
+		pkgIndex int
+	}{
+		// "Bump package from x to y" or "Bump package to y"
+		{regexp.MustCompile(`(?i)^[Bb]ump\s+([^\s]+)\s+(?:from|to)`), 1},
+		// "Update package from x to y" or "Update package to y"
+		{regexp.MustCompile(`(?i)^[Uu]pdate\s+([^\s]+)\s+(?:from|to)`), 1},
+		// "chore(deps): bump package from x to y"
+		{regexp.MustCompile(`(?i)^chore.*[Bb]ump\s+([^\s]+)\s+(?:from|to)`), 1},
+	}
+
+	for _, p := range patterns {
+		if matches := p.regex.FindStringSubmatch(title); len(matches) > p.pkgIndex {
+			packageName = matches[p.pkgIndex]
+			break
+		}
+	}
+
+	if packageName == "" {
+		// Fallback: try to extract any package-like string
+		if parts := strings.Fields(title); len(parts) > 1 {
+			for _, part := range parts[1:] {
+				if strings.Contains(part, "/") || strings.Contains(part, "@") {
+					packageName = part
+					break
+				}
+			}
+		}
+	}
+
+	// Extract organization from package name
+	if packageName != "" {
+		// Handle scoped npm packages like @datadog/browser-rum
+		if strings.HasPrefix(packageName, "@") && strings.Contains(packageName, "/") {
+			parts := strings.Split(packageName, "/")
+			orgName = strings.TrimPrefix(parts[0], "@")
+		} else if strings.Contains(packageName, "/") {
+			// Special case for golang.org/x and google.golang.org packages - they don't have an org
+			if strings.HasPrefix(packageName, "golang.org/x/") || strings.HasPrefix(packageName, "google.golang.org/") {
+				orgName = ""
+			} else if strings.HasPrefix(packageName, "gopkg.in/") {
+				// gopkg.in packages can have orgs like gopkg.in/DataDog/dd-trace-go.v1
+				// Extract the org from the second part if it exists
+				parts := strings.Split(packageName, "/")
+				if len(parts) > 2 {
+					// gopkg.in/DataDog/dd-trace-go.v1 -> DataDog
+					orgName = strings.ToLower(parts[1])
+				} else {
+					orgName = ""
+				}
+			} else {
+				// Handle GitHub-style packages like github.com/datadog/datadog-go
+				parts := strings.Split(packageName, "/")
+				// For github.com/owner/repo or github.com/owner/repo/v2
+				// We want the owner (second part)
+				if len(parts) >= 3 && strings.HasPrefix(packageName, "github.com/") {
+					orgName = parts[1]
+				} else {
+					// Fallback for other patterns
+					for i, part := range parts {
+						// Skip domain parts and version indicators
+						if i > 0 && !strings.Contains(part, ".") && !strings.HasPrefix(part, "v") {
+							orgName = part
+							break
+						}
+					}
+				}
+			}
+		}
+	}
+
+	if matches := toVersionRegex.FindAllStringSubmatch(title, -1); len(matches) > 0 {
+		toVersion = strings.TrimSuffix(matches[len(matches)-1][1], ".")
+	}
+
+	return packageName, orgName, toVersion
+}
+
+// isDenied checks whether a package (at the given target version) or its
+// organization is denied, returning a SkipReason naming the specific rule
+// that matched.
+func isDenied(packageName, orgName, version string, deniedPackages []Matcher, deniedOrgs []string) (denied bool, reason string) {
+	for _, m := range deniedPackages {
+		if m.Match(packageName, version) {
+			return true, fmt.Sprintf("package '%s' matched deny rule: %s", packageName, m)
+		}
+	}
+
+	for _, org := range deniedOrgs {
+		if strings.EqualFold(orgName, org) {
+			return true, fmt.Sprintf("org '%s' is denied", orgName)
+		}
+	}
+
+	return false, ""
+}
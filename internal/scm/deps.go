@@ -1,19 +1,51 @@
 package scm
 
+import "time"
+
+// DefaultRenovateBotUsername is the username Renovate and
+// Dependabot-equivalent bots run under on GitLab and Gitea/Forgejo by
+// default, used when a repository doesn't configure its own BotUsername.
+// Unlike GitHub's Dependabot App ID, there's no single bot account name
+// across self-hosted instances, so most real deployments will need to
+// override this.
+const DefaultRenovateBotUsername = "renovate-bot"
+
 // DependencyUpdateQuery contains parameters for querying dependency PRs
 type DependencyUpdateQuery struct {
 	Owner          string
 	Repo           string
-	DeniedPackages []string // List of package names to exclude
-	DeniedOrgs     []string // List of organization names to exclude (e.g., "datadog")
+	BaseURL        string    // API base URL of a self-hosted provider (e.g. Gitea/Forgejo); informational, providers take their base URL when constructed and don't read this field
+	BotUsername    string    // Username the dependency bot runs under on GitLab/Gitea (see DefaultRenovateBotUsername)
+	DeniedPackages []Matcher // Compiled deny rules to exclude, see ParseDenyRule
+	DeniedOrgs     []string  // List of organization names to exclude (e.g., "datadog")
 }
 
 // PRInfo contains information about a pull request
 type PRInfo struct {
-	Number     int
-	Title      string
-	URL        string
-	Status     string // CI status: "success", "failure", "pending", or ""
-	Skipped    bool   // Whether PR would be skipped due to deny lists
-	SkipReason string // Reason for skipping (denied package/org name)
+	Number      int
+	Title       string
+	URL         string
+	PackageName string // Package name parsed from the title, e.g. "github.com/datadog/datadog-go"
+	ToVersion   string // Version the PR bumps to, parsed from the title
+	Status      string // CI status: "success", "failure", "pending", or ""
+	Skipped     bool   // Whether PR would be skipped due to deny lists (or, once enriched, the min_age/deny_deprecated/scorecard gates)
+	SkipReason  string // Reason for skipping (the deny rule, denied org, or gate that matched)
+
+	// Registry metadata, populated by the caller via internal/scm/registry
+	// once the package's ecosystem has been identified. Zero values mean
+	// the lookup wasn't performed or returned nothing.
+	LatestVersion        string
+	PublishedAt          time.Time
+	Deprecated           bool
+	License              string
+	KnownVulnerabilities []string
+	RepositoryURL        string // Package's source repository, if the registry publishes one
+
+	// Scorecard results, populated by the caller via internal/scm/scorecard
+	// once the package's source repository has been resolved. ScorecardRepo
+	// is empty when the repository couldn't be determined or wasn't a
+	// github.com project.
+	ScorecardRepo   string
+	ScorecardScore  float64
+	ScorecardChecks map[string]float64
 }
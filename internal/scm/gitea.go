@@ -0,0 +1,124 @@
+package scm
+
+import (
+	"context"
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+)
+
+type giteaClient struct {
+	client *gitea.Client
+}
+
+// NewGiteaClient builds a giteaClient for a self-hosted Gitea/Forgejo
+// instance at baseURL, authenticating with token.
+func NewGiteaClient(baseURL, token string) (*giteaClient, error) {
+	client, err := gitea.NewClient(baseURL, gitea.SetToken(token))
+	if err != nil {
+		return nil, fmt.Errorf("creating gitea client: %w", err)
+	}
+
+	return &giteaClient{client: client}, nil
+}
+
+// ListDependencyPRs returns all open Renovate/Dependabot pull requests with
+// skip status based on deny lists.
+func (g *giteaClient) ListDependencyPRs(ctx context.Context, q DependencyUpdateQuery) ([]PRInfo, error) {
+	client := g.client
+	if ctx != nil {
+		client.SetContext(ctx)
+	}
+
+	botUsername := q.BotUsername
+	if botUsername == "" {
+		botUsername = DefaultRenovateBotUsername
+	}
+
+	pulls, _, err := client.ListRepoPullRequests(q.Owner, q.Repo, gitea.ListPullRequestsOptions{
+		State: gitea.StateOpen,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var prs []PRInfo
+	for _, p := range pulls {
+		// Only include Renovate/Dependabot-equivalent PRs
+		if p.Poster == nil || p.Poster.UserName != botUsername {
+			continue
+		}
+
+		packageName, orgName, toVersion := extractPackageInfo(p.Title)
+
+		pr := PRInfo{
+			Number:      int(p.Index),
+			Title:       p.Title,
+			URL:         p.HTMLURL,
+			PackageName: packageName,
+			ToVersion:   toVersion,
+		}
+
+		// Check if package or org is denied
+		if denied, reason := isDenied(packageName, orgName, toVersion, q.DeniedPackages, q.DeniedOrgs); denied {
+			pr.Skipped = true
+			pr.SkipReason = reason
+		}
+
+		// Get combined commit status
+		if p.Head != nil {
+			status, _, err := client.GetCombinedStatus(q.Owner, q.Repo, p.Head.Sha)
+			if err == nil {
+				switch status.State {
+				case gitea.StatusSuccess:
+					pr.Status = "success"
+				case gitea.StatusPending:
+					pr.Status = "pending"
+				case gitea.StatusError, gitea.StatusFailure, gitea.StatusWarning:
+					pr.Status = "failure"
+				}
+			}
+		}
+
+		prs = append(prs, pr)
+	}
+
+	return prs, nil
+}
+
+// Approve approves the PR numbered number as the authenticated user.
+func (g *giteaClient) Approve(ctx context.Context, q DependencyUpdateQuery, number int) error {
+	client := g.client
+	if ctx != nil {
+		client.SetContext(ctx)
+	}
+	_, _, err := client.CreatePullReview(q.Owner, q.Repo, int64(number), gitea.CreatePullReviewOptions{
+		State: gitea.ReviewStateApproved,
+	})
+	return err
+}
+
+// Comment posts body as a new comment on the PR numbered number.
+func (g *giteaClient) Comment(ctx context.Context, q DependencyUpdateQuery, number int, body string) error {
+	client := g.client
+	if ctx != nil {
+		client.SetContext(ctx)
+	}
+	_, _, err := client.CreateIssueComment(q.Owner, q.Repo, int64(number), gitea.CreateIssueCommentOption{
+		Body: body,
+	})
+	return err
+}
+
+// Close closes the PR numbered number.
+func (g *giteaClient) Close(ctx context.Context, q DependencyUpdateQuery, number int) error {
+	client := g.client
+	if ctx != nil {
+		client.SetContext(ctx)
+	}
+	closed := gitea.StateClosed
+	_, _, err := client.EditIssue(q.Owner, q.Repo, int64(number), gitea.EditIssueOption{
+		State: &closed,
+	})
+	return err
+}
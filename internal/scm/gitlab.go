@@ -0,0 +1,137 @@
+package scm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+type gitlabClient struct {
+	client *gitlab.Client
+}
+
+// NewGitlabClient builds a gitlabClient for gitlab.com or a self-hosted
+// instance. baseURL may be empty, in which case gitlab.com is used.
+func NewGitlabClient(token string, baseURL string) (*gitlabClient, error) {
+	var opts []gitlab.ClientOptionFunc
+	if baseURL != "" {
+		opts = append(opts, gitlab.WithBaseURL(baseURL))
+	}
+
+	client, err := gitlab.NewClient(token, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("creating gitlab client: %w", err)
+	}
+
+	return &gitlabClient{client: client}, nil
+}
+
+// ListDependencyPRs returns all open Renovate/Dependabot merge requests with
+// skip status based on deny lists.
+func (g *gitlabClient) ListDependencyPRs(ctx context.Context, q DependencyUpdateQuery) ([]PRInfo, error) {
+	projectID := fmt.Sprintf("%s/%s", q.Owner, q.Repo)
+
+	botUsername := q.BotUsername
+	if botUsername == "" {
+		botUsername = DefaultRenovateBotUsername
+	}
+
+	opened := "opened"
+	mrs, _, err := g.client.MergeRequests.ListProjectMergeRequests(projectID, &gitlab.ListProjectMergeRequestsOptions{
+		State: &opened,
+		ListOptions: gitlab.ListOptions{
+			Page:    0,
+			PerPage: 100,
+		},
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	var prs []PRInfo
+	for _, mr := range mrs {
+		// Only include Renovate/Dependabot-equivalent MRs
+		if mr.Author == nil || mr.Author.Username != botUsername {
+			continue
+		}
+
+		title := mr.Title
+		packageName, orgName, toVersion := extractPackageInfo(title)
+
+		pr := PRInfo{
+			Number:      mr.IID,
+			Title:       title,
+			URL:         mr.WebURL,
+			PackageName: packageName,
+			ToVersion:   toVersion,
+		}
+
+		// Check if package or org is denied
+		if denied, reason := isDenied(packageName, orgName, toVersion, q.DeniedPackages, q.DeniedOrgs); denied {
+			pr.Skipped = true
+			pr.SkipReason = reason
+		}
+
+		// Get pipeline status
+		statuses, _, err := g.client.Commits.GetCommitStatuses(projectID, mr.SHA, &gitlab.GetCommitStatusesOptions{}, gitlab.WithContext(ctx))
+		if err == nil {
+			pr.Status = combinedPipelineStatus(statuses)
+		}
+
+		prs = append(prs, pr)
+	}
+
+	return prs, nil
+}
+
+// Approve approves the MR numbered number as the authenticated user.
+func (g *gitlabClient) Approve(ctx context.Context, q DependencyUpdateQuery, number int) error {
+	projectID := fmt.Sprintf("%s/%s", q.Owner, q.Repo)
+	_, _, err := g.client.MergeRequestApprovals.ApproveMergeRequest(projectID, number, &gitlab.ApproveMergeRequestOptions{}, gitlab.WithContext(ctx))
+	return err
+}
+
+// Comment posts body as a new note on the MR numbered number.
+func (g *gitlabClient) Comment(ctx context.Context, q DependencyUpdateQuery, number int, body string) error {
+	projectID := fmt.Sprintf("%s/%s", q.Owner, q.Repo)
+	_, _, err := g.client.Notes.CreateMergeRequestNote(projectID, number, &gitlab.CreateMergeRequestNoteOptions{
+		Body: &body,
+	}, gitlab.WithContext(ctx))
+	return err
+}
+
+// Close closes the MR numbered number.
+func (g *gitlabClient) Close(ctx context.Context, q DependencyUpdateQuery, number int) error {
+	projectID := fmt.Sprintf("%s/%s", q.Owner, q.Repo)
+	closeEvent := "close"
+	_, _, err := g.client.MergeRequests.UpdateMergeRequest(projectID, number, &gitlab.UpdateMergeRequestOptions{
+		StateEvent: &closeEvent,
+	}, gitlab.WithContext(ctx))
+	return err
+}
+
+// combinedPipelineStatus reduces GitLab's per-job commit statuses to the
+// same "success"/"failure"/"pending" vocabulary GitHub's combined status
+// endpoint uses.
+func combinedPipelineStatus(statuses []*gitlab.CommitStatus) string {
+	if len(statuses) == 0 {
+		return ""
+	}
+
+	sawPending := false
+	for _, s := range statuses {
+		switch s.Status {
+		case "failed", "canceled":
+			return "failure"
+		case "pending", "running", "created":
+			sawPending = true
+		}
+	}
+
+	if sawPending {
+		return "pending"
+	}
+
+	return "success"
+}
@@ -0,0 +1,112 @@
+package scm
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+// Matcher reports whether a package at a given target version matches a
+// single deny-list rule, and describes the rule for SkipReason messages.
+type Matcher interface {
+	Match(packageName, version string) bool
+	fmt.Stringer
+}
+
+// ParseDenyRule compiles a single deny-list entry, as written in config,
+// into a Matcher. Supported forms:
+//
+//	github.com/aws/**                glob, via doublestar (path.Match plus **)
+//	re:^golang\.org/x/.*$             regex
+//	github.com/gin-gonic/gin@<1.9.0   semver constraint, scoped to one package
+//	anything else                    case-insensitive exact package name
+//
+// A leading "@" (e.g. a scoped npm package like "@datadog/browser-rum") is
+// never treated as a semver rule's separator, since the package name itself
+// would be empty.
+func ParseDenyRule(rule string) (Matcher, error) {
+	switch {
+	case strings.HasPrefix(rule, "re:"):
+		pattern := strings.TrimPrefix(rule, "re:")
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regex deny rule %q: %w", rule, err)
+		}
+		return &regexMatcher{raw: rule, re: re}, nil
+
+	case strings.LastIndex(rule, "@") > 0:
+		idx := strings.LastIndex(rule, "@")
+		pkg, constraint := rule[:idx], rule[idx+1:]
+		c, err := semver.NewConstraint(constraint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid semver deny rule %q: %w", rule, err)
+		}
+		return &semverMatcher{raw: rule, pkg: pkg, constraint: c}, nil
+
+	case strings.ContainsAny(rule, "*?["):
+		if !doublestar.ValidatePattern(rule) {
+			return nil, fmt.Errorf("invalid glob deny rule %q", rule)
+		}
+		return &globMatcher{raw: rule}, nil
+
+	default:
+		return &exactMatcher{raw: rule}, nil
+	}
+}
+
+// globMatcher matches a package name against a doublestar glob, e.g.
+// "github.com/aws/**".
+type globMatcher struct {
+	raw string
+}
+
+func (m *globMatcher) Match(packageName, _ string) bool {
+	ok, _ := doublestar.Match(m.raw, packageName)
+	return ok
+}
+
+func (m *globMatcher) String() string { return fmt.Sprintf("glob %q", m.raw) }
+
+// regexMatcher matches a package name against a "re:"-prefixed regular
+// expression.
+type regexMatcher struct {
+	raw string
+	re  *regexp.Regexp
+}
+
+func (m *regexMatcher) Match(packageName, _ string) bool { return m.re.MatchString(packageName) }
+
+func (m *regexMatcher) String() string { return fmt.Sprintf("regex %q", m.raw) }
+
+// semverMatcher denies one specific package when its target version
+// satisfies a semver constraint, e.g. "github.com/gin-gonic/gin@<1.9.0".
+type semverMatcher struct {
+	raw        string
+	pkg        string
+	constraint *semver.Constraints
+}
+
+func (m *semverMatcher) Match(packageName, version string) bool {
+	if !strings.EqualFold(packageName, m.pkg) {
+		return false
+	}
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return false
+	}
+	return m.constraint.Check(v)
+}
+
+func (m *semverMatcher) String() string { return fmt.Sprintf("version constraint %q", m.raw) }
+
+// exactMatcher matches a package name case-insensitively, verbatim.
+type exactMatcher struct {
+	raw string
+}
+
+func (m *exactMatcher) Match(packageName, _ string) bool { return strings.EqualFold(packageName, m.raw) }
+
+func (m *exactMatcher) String() string { return fmt.Sprintf("package %q", m.raw) }
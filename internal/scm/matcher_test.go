@@ -0,0 +1,122 @@
+package scm
+
+import "testing"
+
+func TestParseDenyRule(t *testing.T) {
+	tests := []struct {
+		name      string
+		rule      string
+		wantErr   bool
+		pkg       string
+		version   string
+		wantMatch bool
+	}{
+		{
+			name:      "exact match",
+			rule:      "lodash",
+			pkg:       "Lodash", // exactMatcher is case-insensitive
+			wantMatch: true,
+		},
+		{
+			name:      "exact no match",
+			rule:      "lodash",
+			pkg:       "underscore",
+			wantMatch: false,
+		},
+		{
+			name:      "glob match",
+			rule:      "github.com/aws/**",
+			pkg:       "github.com/aws/aws-sdk-go",
+			wantMatch: true,
+		},
+		{
+			name:      "glob no match",
+			rule:      "github.com/aws/**",
+			pkg:       "github.com/gin-gonic/gin",
+			wantMatch: false,
+		},
+		{
+			name:    "invalid glob",
+			rule:    "github.com/aws/[",
+			wantErr: true,
+		},
+		{
+			name:      "regex match",
+			rule:      `re:^golang\.org/x/.*$`,
+			pkg:       "golang.org/x/sys",
+			wantMatch: true,
+		},
+		{
+			name:      "regex no match",
+			rule:      `re:^golang\.org/x/.*$`,
+			pkg:       "github.com/gin-gonic/gin",
+			wantMatch: false,
+		},
+		{
+			name:    "invalid regex",
+			rule:    "re:(",
+			wantErr: true,
+		},
+		{
+			name:      "semver constraint matched version",
+			rule:      "github.com/gin-gonic/gin@<1.9.0",
+			pkg:       "github.com/gin-gonic/gin",
+			version:   "1.8.0",
+			wantMatch: true,
+		},
+		{
+			name:      "semver constraint version out of range",
+			rule:      "github.com/gin-gonic/gin@<1.9.0",
+			pkg:       "github.com/gin-gonic/gin",
+			version:   "1.9.0",
+			wantMatch: false,
+		},
+		{
+			name:      "semver constraint different package",
+			rule:      "github.com/gin-gonic/gin@<1.9.0",
+			pkg:       "github.com/aws/aws-sdk-go",
+			version:   "1.0.0",
+			wantMatch: false,
+		},
+		{
+			name:    "invalid semver constraint",
+			rule:    "github.com/gin-gonic/gin@not-a-constraint",
+			wantErr: true,
+		},
+		{
+			// A leading "@" (scoped npm package) must not be parsed as a
+			// semver rule's package/constraint separator, since that would
+			// leave an empty package name. See 858b8fd.
+			name:      "scoped npm name falls back to exact match",
+			rule:      "@datadog/browser-rum",
+			pkg:       "@datadog/browser-rum",
+			wantMatch: true,
+		},
+		{
+			name:      "scoped npm name with version constraint",
+			rule:      "@datadog/browser-rum@<5.0.0",
+			pkg:       "@datadog/browser-rum",
+			version:   "4.0.0",
+			wantMatch: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := ParseDenyRule(tt.rule)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseDenyRule(%q) = nil error, want error", tt.rule)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDenyRule(%q) returned error: %v", tt.rule, err)
+			}
+
+			if got := m.Match(tt.pkg, tt.version); got != tt.wantMatch {
+				t.Errorf("Match(%q, %q) = %v, want %v", tt.pkg, tt.version, got, tt.wantMatch)
+			}
+		})
+	}
+}
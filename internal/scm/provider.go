@@ -0,0 +1,24 @@
+package scm
+
+import "context"
+
+// SCMProvider is implemented by each source control platform dependawhat
+// knows how to inspect for open dependency-update PRs/MRs.
+type SCMProvider interface {
+	// ListDependencyPRs returns all open dependency-update PRs/MRs for the
+	// repository described by q, annotated with deny list skip status and,
+	// where available, CI/pipeline status.
+	ListDependencyPRs(ctx context.Context, q DependencyUpdateQuery) ([]PRInfo, error)
+
+	// Approve approves the PR/MR numbered number in the repository
+	// described by q.
+	Approve(ctx context.Context, q DependencyUpdateQuery, number int) error
+
+	// Comment posts body as a new comment on the PR/MR numbered number in
+	// the repository described by q.
+	Comment(ctx context.Context, q DependencyUpdateQuery, number int, body string) error
+
+	// Close closes the PR/MR numbered number in the repository described
+	// by q.
+	Close(ctx context.Context, q DependencyUpdateQuery, number int) error
+}
@@ -0,0 +1,61 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// goProxyLatest mirrors the relevant fields of the Go module proxy's
+// @latest response: https://proxy.golang.org/<module>/@latest
+type goProxyLatest struct {
+	Version string    `json:"Version"`
+	Time    time.Time `json:"Time"`
+}
+
+func (c *Client) lookupGoModule(ctx context.Context, module string) (Metadata, error) {
+	reqURL := fmt.Sprintf("https://proxy.golang.org/%s/@latest", escapeModulePath(module))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return Metadata{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Metadata{}, fmt.Errorf("go proxy: unexpected status %s for %s", resp.Status, module)
+	}
+
+	var latest goProxyLatest
+	if err := json.NewDecoder(resp.Body).Decode(&latest); err != nil {
+		return Metadata{}, fmt.Errorf("go proxy: decoding response for %s: %w", module, err)
+	}
+
+	return Metadata{
+		LatestVersion: latest.Version,
+		PublishedAt:   latest.Time,
+	}, nil
+}
+
+// escapeModulePath applies the Go module proxy's "!" escaping for upper-case
+// letters, as documented at https://go.dev/ref/mod#module-proxy.
+func escapeModulePath(path string) string {
+	var b strings.Builder
+	for _, r := range path {
+		if r >= 'A' && r <= 'Z' {
+			b.WriteByte('!')
+			b.WriteRune(r + ('a' - 'A'))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,78 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// npmPackageDoc mirrors the relevant fields of npm's package metadata
+// document: https://registry.npmjs.com/<name>
+type npmPackageDoc struct {
+	DistTags map[string]string `json:"dist-tags"`
+	Time     map[string]string `json:"time"`
+	Versions map[string]struct {
+		Deprecated string `json:"deprecated"`
+		License    string `json:"license"`
+		Dist       struct {
+			Tarball string `json:"tarball"`
+			Shasum  string `json:"shasum"`
+		} `json:"dist"`
+		Repository struct {
+			URL string `json:"url"`
+		} `json:"repository"`
+	} `json:"versions"`
+}
+
+func (c *Client) lookupNPMPackage(ctx context.Context, name, version string) (Metadata, error) {
+	// Scoped packages (@scope/name) need their slash preserved, not escaped.
+	escaped := name
+	if !strings.HasPrefix(name, "@") {
+		escaped = url.PathEscape(name)
+	}
+	reqURL := "https://registry.npmjs.com/" + escaped
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return Metadata{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Metadata{}, fmt.Errorf("npm registry: unexpected status %s for %s", resp.Status, name)
+	}
+
+	var doc npmPackageDoc
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return Metadata{}, fmt.Errorf("npm registry: decoding response for %s: %w", name, err)
+	}
+
+	latest := doc.DistTags["latest"]
+
+	var publishedAt time.Time
+	if ts, ok := doc.Time[latest]; ok {
+		publishedAt, _ = time.Parse(time.RFC3339, ts)
+	}
+
+	v, ok := doc.Versions[version]
+	if !ok {
+		v = doc.Versions[latest]
+	}
+
+	return Metadata{
+		LatestVersion: latest,
+		PublishedAt:   publishedAt,
+		Deprecated:    v.Deprecated != "",
+		License:       v.License,
+		RepositoryURL: v.Repository.URL,
+	}, nil
+}
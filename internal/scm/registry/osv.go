@@ -0,0 +1,92 @@
+package registry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// osvQuery is the request body for https://api.osv.dev/v1/query, querying
+// known vulnerabilities for one package at one version.
+type osvQuery struct {
+	Version string     `json:"version"`
+	Package osvPackage `json:"package"`
+}
+
+type osvPackage struct {
+	Name      string `json:"name"`
+	Ecosystem string `json:"ecosystem"`
+}
+
+// osvResponse mirrors the relevant fields of OSV's query response.
+type osvResponse struct {
+	Vulns []struct {
+		ID string `json:"id"`
+	} `json:"vulns"`
+}
+
+// osvEcosystem maps an Ecosystem to the ecosystem name OSV's API expects,
+// as listed at https://ossf.github.io/osv-schema/#affectedpackage-field.
+// Returns "" for ecosystems OSV doesn't track, telling the caller to skip
+// the lookup.
+func osvEcosystem(ecosystem Ecosystem) string {
+	switch ecosystem {
+	case EcosystemGo:
+		return "Go"
+	case EcosystemNPM:
+		return "npm"
+	case EcosystemPyPI:
+		return "PyPI"
+	default:
+		return ""
+	}
+}
+
+// lookupVulnerabilities fetches the known OSV vulnerability IDs affecting
+// name@version in the given ecosystem, from the public API at
+// https://api.osv.dev. Returns nil IDs and no error when the ecosystem
+// isn't one OSV tracks.
+func (c *Client) lookupVulnerabilities(ctx context.Context, ecosystem Ecosystem, name, version string) ([]string, error) {
+	osvEco := osvEcosystem(ecosystem)
+	if osvEco == "" {
+		return nil, nil
+	}
+
+	body, err := json.Marshal(osvQuery{
+		Version: version,
+		Package: osvPackage{Name: name, Ecosystem: osvEco},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.osv.dev/v1/query", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("osv api: unexpected status %s for %s@%s", resp.Status, name, version)
+	}
+
+	var doc osvResponse
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("osv api: decoding response for %s@%s: %w", name, version, err)
+	}
+
+	ids := make([]string, 0, len(doc.Vulns))
+	for _, v := range doc.Vulns {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, nil
+}
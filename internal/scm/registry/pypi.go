@@ -0,0 +1,69 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// pypiPackageDoc mirrors the relevant fields of PyPI's JSON API response:
+// https://pypi.org/pypi/<name>/json
+type pypiPackageDoc struct {
+	Info struct {
+		Version     string            `json:"version"`
+		License     string            `json:"license"`
+		ProjectURLs map[string]string `json:"project_urls"`
+	} `json:"info"`
+	Releases map[string][]struct {
+		UploadTimeISO8601 string `json:"upload_time_iso_8601"`
+	} `json:"releases"`
+}
+
+func (c *Client) lookupPyPIPackage(ctx context.Context, name string) (Metadata, error) {
+	reqURL := fmt.Sprintf("https://pypi.org/pypi/%s/json", name)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return Metadata{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Metadata{}, fmt.Errorf("pypi: unexpected status %s for %s", resp.Status, name)
+	}
+
+	var doc pypiPackageDoc
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return Metadata{}, fmt.Errorf("pypi: decoding response for %s: %w", name, err)
+	}
+
+	var publishedAt time.Time
+	if releases, ok := doc.Releases[doc.Info.Version]; ok && len(releases) > 0 {
+		publishedAt, _ = time.Parse(time.RFC3339, releases[0].UploadTimeISO8601)
+	}
+
+	return Metadata{
+		LatestVersion: doc.Info.Version,
+		PublishedAt:   publishedAt,
+		License:       doc.Info.License,
+		RepositoryURL: pypiRepositoryURL(doc.Info.ProjectURLs),
+	}, nil
+}
+
+// pypiRepositoryURL picks the most likely source repository link out of a
+// PyPI project's "project_urls", which have no fixed key naming.
+func pypiRepositoryURL(urls map[string]string) string {
+	for _, key := range []string{"Source", "Source Code", "Repository", "Homepage"} {
+		if u, ok := urls[key]; ok {
+			return u
+		}
+	}
+	return ""
+}
@@ -0,0 +1,154 @@
+// Package registry enriches dependency update PRs with metadata fetched
+// from each ecosystem's upstream package registry (the Go module proxy,
+// npm, and PyPI) and known vulnerabilities from OSV (https://osv.dev).
+package registry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Ecosystem identifies which upstream package registry a dependency
+// belongs to.
+type Ecosystem string
+
+const (
+	EcosystemGo      Ecosystem = "go"
+	EcosystemNPM     Ecosystem = "npm"
+	EcosystemPyPI    Ecosystem = "pypi"
+	EcosystemUnknown Ecosystem = ""
+)
+
+// Metadata holds the upstream registry facts used to enrich a PRInfo.
+type Metadata struct {
+	LatestVersion        string
+	PublishedAt          time.Time
+	Deprecated           bool
+	License              string
+	KnownVulnerabilities []string
+
+	// RepositoryURL is the package's source repository, as published by
+	// the registry (e.g. npm's "repository.url" or PyPI's project_urls).
+	// Empty when the registry doesn't publish one.
+	RepositoryURL string
+}
+
+// cacheKey identifies one (ecosystem, package, version) lookup, so PRs that
+// bump the same dependency only hit the network once per run.
+type cacheKey struct {
+	ecosystem Ecosystem
+	name      string
+	version   string
+}
+
+// Client looks up package metadata from upstream registries, caching
+// results for its lifetime to avoid rate limits when many PRs reference
+// the same dependency.
+type Client struct {
+	http *http.Client
+
+	mu    sync.Mutex
+	cache map[cacheKey]Metadata
+}
+
+// NewClient returns a Client that issues requests with httpClient.
+func NewClient(httpClient *http.Client) *Client {
+	return &Client{
+		http:  httpClient,
+		cache: make(map[cacheKey]Metadata),
+	}
+}
+
+// DetectEcosystem makes a best-effort guess at which registry a dependency
+// bot PR's package name belongs to, based on its shape. A package name alone
+// can't always distinguish npm from PyPI - e.g. "requests" is a valid name
+// in both - so callers that know better (e.g. a per-repository config
+// override) should prefer that over this guess.
+func DetectEcosystem(packageName string) Ecosystem {
+	switch {
+	case packageName == "":
+		return EcosystemUnknown
+	case strings.HasPrefix(packageName, "@") && strings.Contains(packageName, "/"):
+		return EcosystemNPM
+	case isGoModulePath(packageName):
+		return EcosystemGo
+	default:
+		// Most Dependabot/Renovate PRs for a name that isn't a Go module
+		// path are npm, so guess npm rather than giving up and leaving the
+		// PR unenriched.
+		return EcosystemNPM
+	}
+}
+
+// isGoModulePath reports whether name looks like a Go module path, i.e.
+// "<host-with-a-dot>/<path>", such as github.com/owner/repo or golang.org/x/sys.
+func isGoModulePath(name string) bool {
+	host, _, found := strings.Cut(name, "/")
+	return found && strings.Contains(host, ".")
+}
+
+// Lookup fetches metadata for name@version from the given ecosystem's
+// registry, serving from cache when available. It returns a zero Metadata
+// and no error for EcosystemUnknown.
+func (c *Client) Lookup(ctx context.Context, ecosystem Ecosystem, name, version string) (Metadata, error) {
+	key := cacheKey{ecosystem: ecosystem, name: name, version: version}
+
+	c.mu.Lock()
+	if m, ok := c.cache[key]; ok {
+		c.mu.Unlock()
+		return m, nil
+	}
+	c.mu.Unlock()
+
+	var (
+		m   Metadata
+		err error
+	)
+	switch ecosystem {
+	case EcosystemGo:
+		m, err = c.lookupGoModule(ctx, name)
+	case EcosystemNPM:
+		m, err = c.lookupNPMPackage(ctx, name, version)
+	case EcosystemPyPI:
+		m, err = c.lookupPyPIPackage(ctx, name)
+	default:
+		return Metadata{}, nil
+	}
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	// Best-effort: a failed or unsupported vulnerability lookup shouldn't
+	// fail the whole enrichment, since the rest of m is still useful.
+	if version != "" {
+		if vulns, vErr := c.lookupVulnerabilities(ctx, ecosystem, name, version); vErr == nil {
+			m.KnownVulnerabilities = vulns
+		}
+	}
+
+	c.mu.Lock()
+	c.cache[key] = m
+	c.mu.Unlock()
+
+	return m, nil
+}
+
+// ParseAge parses a duration string like "3d", "12h", or "90m" into a
+// time.Duration. time.ParseDuration doesn't understand a "d" (day) suffix,
+// which is the unit config authors reach for most often when writing
+// min_age thresholds.
+func ParseAge(s string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.ParseFloat(days, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid age %q: %w", s, err)
+		}
+		return time.Duration(n * float64(24*time.Hour)), nil
+	}
+	return time.ParseDuration(s)
+}
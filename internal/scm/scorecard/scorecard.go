@@ -0,0 +1,189 @@
+// Package scorecard gates dependency update PRs on OSSF Scorecard signals
+// for the package's upstream source repository, fetched from the public
+// API at https://api.securityscorecards.dev.
+package scorecard
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Result holds the Scorecard facts needed to evaluate a require_scorecard
+// gate for one source repository.
+type Result struct {
+	Repo        string             `json:"repo"`
+	Commit      string             `json:"commit"`
+	Score       float64            `json:"score"`
+	CheckScores map[string]float64 `json:"check_scores"`
+	FetchedAt   time.Time          `json:"fetched_at"`
+}
+
+// Requirement is one repository's "require_scorecard:" gate: an overall
+// minimum score and, optionally, per-check minimums.
+type Requirement struct {
+	Min    float64
+	Checks map[string]float64
+}
+
+// Evaluate reports whether result clears req, and if not, a SkipReason-style
+// explanation naming a failing check (the overall score if that's what
+// failed). Check names are compared case-insensitively. When several
+// per-check minimums fail, which one is named is not defined.
+func (req Requirement) Evaluate(result Result) (ok bool, reason string) {
+	if result.Score < req.Min {
+		return false, fmt.Sprintf("scorecard %.1f < %.1f", result.Score, req.Min)
+	}
+	for name, min := range req.Checks {
+		for check, score := range result.CheckScores {
+			if strings.EqualFold(check, name) && score < min {
+				return false, fmt.Sprintf("scorecard check %s=%g < %g (overall %.1f)", check, score, min, result.Score)
+			}
+		}
+	}
+	return true, ""
+}
+
+// Client fetches and disk-caches Scorecard results from the public API.
+// Results are cached per repo under cacheDir for ttl, so repeated runs
+// against the same dependency don't re-hit the API on every invocation.
+type Client struct {
+	http     *http.Client
+	cacheDir string
+	ttl      time.Duration
+}
+
+// NewClient returns a Client caching results under cacheDir for ttl. An
+// empty cacheDir disables the disk cache (every Lookup hits the API).
+func NewClient(httpClient *http.Client, cacheDir string, ttl time.Duration) *Client {
+	return &Client{http: httpClient, cacheDir: cacheDir, ttl: ttl}
+}
+
+// projectResponse mirrors the relevant fields of
+// https://api.securityscorecards.dev/projects/<repo>
+type projectResponse struct {
+	Repo struct {
+		Name   string `json:"name"`
+		Commit string `json:"commit"`
+	} `json:"repo"`
+	Score  float64 `json:"score"`
+	Checks []struct {
+		Name  string  `json:"name"`
+		Score float64 `json:"score"`
+	} `json:"checks"`
+}
+
+// Lookup fetches the Scorecard result for repo (e.g.
+// "github.com/aws/aws-sdk-go"), serving a disk-cached result when one
+// younger than the client's TTL exists. It degrades gracefully: an
+// unreachable API or an unscored project yields a zero Result and a nil
+// error rather than failing the caller's run.
+func (c *Client) Lookup(ctx context.Context, repo string) (Result, error) {
+	if cached, ok := c.readCache(repo); ok {
+		return cached, nil
+	}
+
+	result, err := c.fetch(ctx, repo)
+	if err != nil {
+		return Result{}, nil
+	}
+
+	c.writeCache(repo, result)
+	return result, nil
+}
+
+func (c *Client) fetch(ctx context.Context, repo string) (Result, error) {
+	reqURL := "https://api.securityscorecards.dev/projects/" + repo
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return Result{}, err
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return Result{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Result{}, fmt.Errorf("scorecard api: unexpected status %s for %s", resp.Status, repo)
+	}
+
+	var doc projectResponse
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return Result{}, fmt.Errorf("scorecard api: decoding response for %s: %w", repo, err)
+	}
+
+	checkScores := make(map[string]float64, len(doc.Checks))
+	for _, check := range doc.Checks {
+		checkScores[check.Name] = check.Score
+	}
+
+	return Result{
+		Repo:        repo,
+		Commit:      doc.Repo.Commit,
+		Score:       doc.Score,
+		CheckScores: checkScores,
+		FetchedAt:   time.Now(),
+	}, nil
+}
+
+// cacheFile returns the on-disk path a repo's cached Result is stored
+// under, named from a hash of the repo so arbitrary owner/repo strings
+// can't escape cacheDir or collide on path separators.
+func (c *Client) cacheFile(repo string) string {
+	sum := sha256.Sum256([]byte(repo))
+	return filepath.Join(c.cacheDir, hex.EncodeToString(sum[:])+".json")
+}
+
+// readCache returns the cached Result for repo if the disk cache is
+// enabled, present, and not yet older than the client's TTL. The cached
+// commit is kept purely for the record - the public projects API has no
+// way to check it's still current without a fetch, so TTL expiry is what
+// actually drives a refresh.
+func (c *Client) readCache(repo string) (Result, bool) {
+	if c.cacheDir == "" {
+		return Result{}, false
+	}
+
+	b, err := os.ReadFile(c.cacheFile(repo))
+	if err != nil {
+		return Result{}, false
+	}
+
+	var result Result
+	if err := json.Unmarshal(b, &result); err != nil {
+		return Result{}, false
+	}
+
+	if time.Since(result.FetchedAt) >= c.ttl {
+		return Result{}, false
+	}
+
+	return result, true
+}
+
+func (c *Client) writeCache(repo string, result Result) {
+	if c.cacheDir == "" {
+		return
+	}
+
+	b, err := json.Marshal(result)
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(c.cacheDir, 0o755); err != nil {
+		return
+	}
+
+	_ = os.WriteFile(c.cacheFile(repo), b, 0o644)
+}
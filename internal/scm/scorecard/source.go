@@ -0,0 +1,32 @@
+package scorecard
+
+import "strings"
+
+// ParseGitHubRepo normalizes raw - a Go module path, an npm/PyPI
+// "repository" URL, or an SSH/HTTPS git remote - down to the
+// "github.com/<owner>/<repo>" form the Scorecard API's project path
+// expects. It reports false for anything that doesn't resolve to a
+// github.com repository; Scorecard-gating is best-effort and silently
+// skipped for packages hosted elsewhere.
+func ParseGitHubRepo(raw string) (repo string, ok bool) {
+	s := raw
+	s = strings.TrimPrefix(s, "git+")
+	s = strings.TrimSuffix(s, ".git")
+
+	if _, after, found := strings.Cut(s, "://"); found {
+		s = after
+	}
+	s = strings.TrimPrefix(s, "git@")
+	s = strings.Replace(s, "github.com:", "github.com/", 1)
+
+	if !strings.HasPrefix(s, "github.com/") {
+		return "", false
+	}
+
+	parts := strings.Split(strings.TrimPrefix(s, "github.com/"), "/")
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", false
+	}
+
+	return "github.com/" + parts[0] + "/" + parts[1], true
+}